@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crosslink
+
+import "fmt"
+
+// ReplaceChange describes a single replace directive that Check found to
+// differ between what is on disk and what Crosslink would compute.
+type ReplaceChange struct {
+	// Path is the module path of the replace's left-hand side.
+	Path string
+	// Before is the replace target currently on disk, empty if the replace
+	// doesn't exist yet.
+	Before string
+	// After is the replace target Crosslink would write, empty if the
+	// replace would be removed.
+	After string
+}
+
+// Diff is the set of changes Check found for a single module.
+type Diff struct {
+	// ModulePath is the module path the diff applies to.
+	ModulePath string
+	// Added are replace directives Crosslink would add.
+	Added []ReplaceChange
+	// Removed are replace directives Crosslink would remove (only populated
+	// when cfg.Prune is set).
+	Removed []ReplaceChange
+	// Changed are replace directives Crosslink would update to a different
+	// target.
+	Changed []ReplaceChange
+}
+
+// IsEmpty reports whether the diff contains any changes.
+func (d Diff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// Check runs the same replace-computation pipeline as Crosslink, but instead
+// of writing the result to disk, it compares the computed go.mod against
+// what is already there and returns a Diff per module. A caller can use a
+// non-empty Diff to fail CI when the replace directives in the go.mod files
+// have drifted out of date.
+func Check(cfg RunConfig) ([]Diff, error) {
+	if cfg.Workspace {
+		return nil, fmt.Errorf("check does not support workspace mode")
+	}
+
+	mods, err := discoverModules(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover modules in %s: %w", cfg.RootPath, err)
+	}
+
+	byPath := make(map[string]*moduleInfo, len(mods))
+	for _, m := range mods {
+		byPath[m.modPath] = m
+	}
+
+	diffs := make([]Diff, 0, len(mods))
+	for _, m := range mods {
+		if _, excluded := cfg.ExcludedPaths[m.modPath]; excluded {
+			continue
+		}
+
+		reachable := reachableModules(m, byPath)
+		targets, err := intendedReplaceTargets(m, reachable)
+		if err != nil {
+			return nil, err
+		}
+
+		d := Diff{ModulePath: m.modPath}
+
+		for path, rel := range targets {
+			if _, excluded := cfg.ExcludedPaths[path]; excluded {
+				continue
+			}
+
+			existing := findReplace(m.file, path)
+			switch {
+			case existing == nil:
+				d.Added = append(d.Added, ReplaceChange{Path: path, After: rel})
+			case existing.New.Path != rel && cfg.Overwrite:
+				d.Changed = append(d.Changed, ReplaceChange{Path: path, Before: existing.New.Path, After: rel})
+			}
+		}
+
+		if cfg.Prune {
+			for _, r := range m.file.Replace {
+				if r.New.Version != "" {
+					continue
+				}
+				if _, ok := reachable[r.Old.Path]; ok {
+					continue
+				}
+				if _, excluded := cfg.ExcludedPaths[r.Old.Path]; excluded {
+					continue
+				}
+				d.Removed = append(d.Removed, ReplaceChange{Path: r.Old.Path, Before: r.New.Path})
+			}
+		}
+
+		diffs = append(diffs, d)
+	}
+
+	return diffs, nil
+}
@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crosslink
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		testName   string
+		mockDir    string
+		preprocess bool
+		wantEmpty  bool
+	}{
+		{
+			testName:   "un-crosslinked tree reports a diff",
+			mockDir:    "testSimple",
+			preprocess: false,
+			wantEmpty:  false,
+		},
+		{
+			testName:   "already crosslinked tree reports no diff",
+			mockDir:    "testSimple",
+			preprocess: true,
+			wantEmpty:  true,
+		},
+		{
+			testName:   "un-crosslinked cyclic tree reports a diff",
+			mockDir:    "testCyclic",
+			preprocess: false,
+			wantEmpty:  false,
+		},
+		{
+			testName:   "already crosslinked cyclic tree reports no diff",
+			mockDir:    "testCyclic",
+			preprocess: true,
+			wantEmpty:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			tmpRootDir := createTempTestDir(t, test.mockDir)
+			require.NoError(t, renameGoMod(tmpRootDir))
+
+			cfg := DefaultRunConfig()
+			cfg.RootPath = tmpRootDir
+
+			if test.preprocess {
+				require.NoError(t, Crosslink(cfg))
+			}
+
+			diffs, err := Check(cfg)
+			require.NoError(t, err)
+
+			allEmpty := true
+			for _, d := range diffs {
+				if !d.IsEmpty() {
+					allEmpty = false
+					break
+				}
+			}
+			assert.Equal(t, test.wantEmpty, allEmpty)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crosslink
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+var (
+	rootCmd = &cobra.Command{
+		Use:   "crosslink",
+		Short: "Intra-repository dependency linker",
+		Long:  "crosslink manages the replace directives between modules of a multi-module Go repository.",
+		RunE:  runCrosslink,
+	}
+
+	overwrite          bool
+	prune              bool
+	verbose            bool
+	excludedPaths      []string
+	skippedPaths       []string
+	workspace          bool
+	workspaceGoVersion string
+	pruneReplacesFlag  bool
+
+	convertCmd = &cobra.Command{
+		Use:   "convert",
+		Short: "Seed go.mod files from legacy dependency manifests",
+		Long:  "convert discovers legacy manifests (Gopkg.lock, glide.lock, Godeps/Godeps.json, vendor/vendor.json, vendor/manifest) and seeds a go.mod for each module found, before crosslinking the tree.",
+		RunE:  runConvert,
+	}
+
+	checkCmd = &cobra.Command{
+		Use:   "check",
+		Short: "Verify that crosslink is up to date",
+		Long:  "check runs the same replace-computation crosslink does, but fails instead of writing when any module's go.mod has drifted, so CI can gate on crosslink being up to date. It is only available as this subcommand; crosslink has no --verify flag.",
+		RunE:  runCheck,
+	}
+)
+
+// BuildAndExecute runs the crosslink command.
+func BuildAndExecute() error {
+	rootCmd.AddCommand(convertCmd, checkCmd)
+
+	for _, cmd := range []*cobra.Command{rootCmd, convertCmd, checkCmd} {
+		cmd.Flags().BoolVar(&overwrite, "overwrite", false, "overwrite existing replace statements")
+		cmd.Flags().BoolVar(&prune, "prune", false, "remove replace statements that are no longer needed")
+		cmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "verbose logging")
+		cmd.Flags().StringSliceVar(&excludedPaths, "exclude", nil, "module paths whose own go.mod is left untouched")
+		cmd.Flags().StringSliceVar(&skippedPaths, "skip", nil, "go.mod paths, relative to the root, to skip entirely")
+		cmd.Flags().BoolVar(&workspace, "workspace", false, "emit a go.work file listing every discovered module instead of injecting replace directives")
+		cmd.Flags().StringVar(&workspaceGoVersion, "workspace-go", "1.20", "go directive written to go.work, only used with --workspace")
+		cmd.Flags().BoolVar(&pruneReplacesFlag, "prune-replaces", false, "strip every local-directory replace directive, not only the ones crosslink injected, from every module's go.mod, only used with --workspace")
+	}
+
+	return rootCmd.Execute()
+}
+
+func runConfig() (RunConfig, error) {
+	lg, err := zap.NewDevelopment()
+	if err != nil {
+		return RunConfig{}, fmt.Errorf("failed to create logger: %w", err)
+	}
+
+	root, err := os.Getwd()
+	if err != nil {
+		return RunConfig{}, fmt.Errorf("failed to determine working directory: %w", err)
+	}
+
+	cfg := DefaultRunConfig()
+	cfg.RootPath = root
+	cfg.Overwrite = overwrite
+	cfg.Prune = prune
+	cfg.Verbose = verbose
+	cfg.Workspace = workspace
+	cfg.WorkspaceGoVersion = workspaceGoVersion
+	cfg.PruneReplaces = pruneReplacesFlag
+	cfg.Logger = lg
+	for _, p := range excludedPaths {
+		cfg.ExcludedPaths[p] = struct{}{}
+	}
+	for _, p := range skippedPaths {
+		cfg.SkippedPaths[p] = struct{}{}
+	}
+	return cfg, nil
+}
+
+func runCrosslink(*cobra.Command, []string) error {
+	cfg, err := runConfig()
+	if err != nil {
+		return err
+	}
+	return Crosslink(cfg)
+}
+
+func runConvert(*cobra.Command, []string) error {
+	cfg, err := runConfig()
+	if err != nil {
+		return err
+	}
+	return Convert(cfg)
+}
+
+func runCheck(cmd *cobra.Command, _ []string) error {
+	cfg, err := runConfig()
+	if err != nil {
+		return err
+	}
+
+	diffs, err := Check(cfg)
+	if err != nil {
+		return err
+	}
+
+	var outOfDate bool
+	for _, d := range diffs {
+		if d.IsEmpty() {
+			continue
+		}
+		outOfDate = true
+		fmt.Fprintf(cmd.OutOrStdout(), "%s is out of date: %d added, %d changed, %d removed replace(s)\n",
+			d.ModulePath, len(d.Added), len(d.Changed), len(d.Removed))
+	}
+	if outOfDate {
+		return fmt.Errorf("crosslink is out of date, run `crosslink` to update")
+	}
+	return nil
+}
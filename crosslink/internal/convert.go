@@ -0,0 +1,130 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crosslink
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"go.uber.org/zap"
+	"golang.org/x/mod/modfile"
+
+	"go.opentelemetry.io/build-tools/crosslink/internal/modconv"
+)
+
+// Convert walks cfg.RootPath looking for submodules that are still pinned by
+// a legacy, pre-modules dependency manifest (see modconv.Registry for the
+// supported formats). For every submodule it finds one in that has no go.mod
+// yet, Convert writes a go.mod requiring the versions the manifest pins. A
+// submodule that already has a go.mod is left as it is unless cfg.Overwrite
+// is set, in which case its go.mod is replaced by the seeded one. Convert
+// then runs Crosslink so the new go.mod picks up the usual intra-repo
+// replace directives.
+func Convert(cfg RunConfig) error {
+	if cfg.Logger == nil {
+		cfg.Logger = zap.NewNop()
+	}
+
+	rootGoMod, err := os.ReadFile(filepath.Clean(filepath.Join(cfg.RootPath, "go.mod")))
+	if err != nil {
+		return fmt.Errorf("no go.mod found at root path %s: %w", cfg.RootPath, err)
+	}
+	rootFile, err := modfile.Parse("go.mod", rootGoMod, nil)
+	if err != nil {
+		return fmt.Errorf("failed to parse root go.mod: %w", err)
+	}
+
+	err = filepath.Walk(cfg.RootPath, func(dir string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(cfg.RootPath, dir)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if _, skipped := cfg.SkippedPaths[path.Join(rel, "go.mod")]; skipped {
+			return filepath.SkipDir
+		}
+
+		modPath := rootFile.Module.Mod.Path
+		if rel != "." {
+			modPath = modPath + "/" + rel
+		}
+		if _, excluded := cfg.ExcludedPaths[modPath]; excluded {
+			return filepath.SkipDir
+		}
+
+		for _, manifest := range modconv.RegistryOrder {
+			convert := modconv.Registry[manifest]
+			manifestPath := filepath.Join(dir, filepath.FromSlash(manifest))
+			data, err := os.ReadFile(filepath.Clean(manifestPath))
+			if err != nil {
+				continue
+			}
+
+			goModPath := filepath.Join(dir, "go.mod")
+			if _, err := os.Stat(goModPath); err == nil && !cfg.Overwrite {
+				cfg.Logger.Debug("go.mod already exists, skipping conversion",
+					zap.String("module", modPath), zap.String("manifest", manifest))
+				continue
+			}
+
+			versions, err := convert(data)
+			if err != nil {
+				return fmt.Errorf("failed to convert %s: %w", manifestPath, err)
+			}
+
+			f := new(modfile.File)
+			if err := f.AddModuleStmt(modPath); err != nil {
+				return fmt.Errorf("failed to seed module statement for %s: %w", modPath, err)
+			}
+			for _, v := range versions {
+				if err := f.AddRequire(v.Path, v.Version); err != nil {
+					return fmt.Errorf("failed to seed require %s for %s: %w", v.Path, modPath, err)
+				}
+			}
+			f.Cleanup()
+
+			out, err := f.Format()
+			if err != nil {
+				return fmt.Errorf("failed to format seeded go.mod for %s: %w", modPath, err)
+			}
+			// #nosec G306 -- go.mod files are not sensitive and must remain readable.
+			if err := os.WriteFile(goModPath, out, 0o644); err != nil {
+				return fmt.Errorf("failed to write seeded go.mod for %s: %w", modPath, err)
+			}
+
+			cfg.Logger.Info("converted legacy manifest to go.mod",
+				zap.String("module", modPath), zap.String("manifest", manifest))
+
+			// Only one legacy manifest is converted per module.
+			break
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return Crosslink(cfg)
+}
@@ -0,0 +1,293 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package crosslink is used to find and update intra-repository dependencies
+// in a multi-module Go repository. It walks the go.mod files rooted at
+// RunConfig.RootPath, discovers modules that depend on one another, and
+// inserts the replace directives needed to build the repository against the
+// local copies rather than whatever has been published.
+package crosslink
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"go.uber.org/zap"
+	"golang.org/x/mod/modfile"
+)
+
+// RunConfig describes how Crosslink and Prune should operate against a
+// repository rooted at RootPath.
+type RunConfig struct {
+	// RootPath is the absolute path to the directory holding the root go.mod
+	// of the repository.
+	RootPath string
+	// Overwrite replaces existing replace directives with the ones computed
+	// by crosslink. When false, crosslink only adds replace directives that
+	// are missing.
+	Overwrite bool
+	// Prune removes replace directives that are no longer needed.
+	Prune bool
+	// Verbose enables debug level logging.
+	Verbose bool
+	// ExcludedPaths are module paths whose own go.mod is left untouched by
+	// Crosslink, even though other modules may still replace them.
+	ExcludedPaths map[string]struct{}
+	// SkippedPaths are go.mod paths, relative to RootPath, that are not
+	// processed at all.
+	SkippedPaths map[string]struct{}
+	// Workspace, when set, makes Crosslink emit a go.work file at RootPath
+	// listing every discovered module instead of injecting per-module
+	// replace directives.
+	Workspace bool
+	// WorkspaceGoVersion is the go directive written to go.work. It is only
+	// used when Workspace is set.
+	WorkspaceGoVersion string
+	// PruneReplaces strips every local-directory replace directive (one with
+	// no version on its right-hand side) from every module's go.mod, not only
+	// the ones Crosslink injected. It is only used when Workspace is set.
+	PruneReplaces bool
+	// Logger is used to report progress and warnings.
+	Logger *zap.Logger
+}
+
+// DefaultRunConfig returns a RunConfig with an empty exclude/skip set and a
+// production logger.
+func DefaultRunConfig() RunConfig {
+	lg, _ := zap.NewProduction()
+	return RunConfig{
+		ExcludedPaths: make(map[string]struct{}),
+		SkippedPaths:  make(map[string]struct{}),
+		Logger:        lg,
+	}
+}
+
+// moduleInfo is the in-memory representation of a single go.mod discovered
+// under RootPath.
+type moduleInfo struct {
+	modPath   string
+	dir       string
+	goModPath string
+	file      *modfile.File
+}
+
+// Crosslink inserts replace directives so that every module under
+// cfg.RootPath resolves its intra-repository dependencies against the local
+// copy rather than a published version.
+func Crosslink(cfg RunConfig) error {
+	if cfg.Logger == nil {
+		cfg.Logger = zap.NewNop()
+	}
+
+	if cfg.Workspace {
+		return writeWorkspace(cfg)
+	}
+
+	mods, err := discoverModules(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to discover modules in %s: %w", cfg.RootPath, err)
+	}
+
+	warnUnmatchedFilters(cfg, mods)
+
+	byPath := make(map[string]*moduleInfo, len(mods))
+	for _, m := range mods {
+		byPath[m.modPath] = m
+	}
+
+	for _, m := range mods {
+		if _, excluded := cfg.ExcludedPaths[m.modPath]; excluded {
+			continue
+		}
+
+		reachable := reachableModules(m, byPath)
+
+		targets, err := intendedReplaceTargets(m, reachable)
+		if err != nil {
+			return err
+		}
+
+		for path, rel := range targets {
+			if _, excluded := cfg.ExcludedPaths[path]; excluded {
+				continue
+			}
+
+			existing := findReplace(m.file, path)
+			if existing != nil && !cfg.Overwrite {
+				continue
+			}
+
+			if err := m.file.AddReplace(path, "", rel, ""); err != nil {
+				return fmt.Errorf("failed to add replace for %s in %s: %w", path, m.modPath, err)
+			}
+		}
+
+		if cfg.Prune {
+			pruneReplaces(m, reachable, cfg.ExcludedPaths)
+		}
+
+		m.file.Cleanup()
+		if err := writeGoMod(m); err != nil {
+			return err
+		}
+
+		if cfg.Verbose {
+			cfg.Logger.Debug("crosslinked module", zap.String("module", m.modPath))
+		}
+	}
+
+	return nil
+}
+
+// Prune removes replace directives inserted by Crosslink that no longer
+// point at a module that is part of the dependency graph.
+func Prune(cfg RunConfig) error {
+	cfg.Prune = true
+	return Crosslink(cfg)
+}
+
+// discoverModules walks cfg.RootPath for go.mod files, honoring
+// cfg.SkippedPaths, and returns the parsed modules.
+func discoverModules(cfg RunConfig) ([]*moduleInfo, error) {
+	if _, err := os.Stat(filepath.Join(cfg.RootPath, "go.mod")); err != nil {
+		return nil, fmt.Errorf("no go.mod found at root path %s: %w", cfg.RootPath, err)
+	}
+
+	var mods []*moduleInfo
+	err := filepath.Walk(cfg.RootPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Base(path) != "go.mod" {
+			return nil
+		}
+
+		rel, err := filepath.Rel(cfg.RootPath, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if _, skipped := cfg.SkippedPaths[rel]; skipped {
+			return nil
+		}
+
+		data, err := os.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return err
+		}
+		f, err := modfile.Parse(path, data, nil)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+
+		mods = append(mods, &moduleInfo{
+			modPath:   f.Module.Mod.Path,
+			dir:       filepath.Dir(path),
+			goModPath: path,
+			file:      f,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mods, nil
+}
+
+// reachableModules returns the set of in-tree modules transitively required
+// by m, starting from m's own require directives.
+func reachableModules(m *moduleInfo, byPath map[string]*moduleInfo) map[string]*moduleInfo {
+	seen := make(map[string]*moduleInfo)
+	var visit func(*moduleInfo)
+	visit = func(cur *moduleInfo) {
+		for _, req := range cur.file.Require {
+			target, ok := byPath[req.Mod.Path]
+			if !ok || target == m {
+				continue
+			}
+			if _, ok := seen[target.modPath]; ok {
+				continue
+			}
+			seen[target.modPath] = target
+			visit(target)
+		}
+	}
+	visit(m)
+	return seen
+}
+
+// intendedReplaceTargets computes, for every module in reachable, the
+// relative path m's replace directive should point at.
+func intendedReplaceTargets(m *moduleInfo, reachable map[string]*moduleInfo) (map[string]string, error) {
+	targets := make(map[string]string, len(reachable))
+	for path, target := range reachable {
+		if path == m.modPath {
+			continue
+		}
+
+		rel, err := filepath.Rel(m.dir, target.dir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute relative path from %s to %s: %w", m.dir, target.dir, err)
+		}
+		rel = filepath.ToSlash(rel)
+		if rel[0] != '.' {
+			rel = "./" + rel
+		}
+		targets[path] = rel
+	}
+	return targets, nil
+}
+
+// findReplace returns the existing replace directive for oldPath in f, or
+// nil if none exists.
+func findReplace(f *modfile.File, oldPath string) *modfile.Replace {
+	for _, r := range f.Replace {
+		if r.Old.Path == oldPath {
+			return r
+		}
+	}
+	return nil
+}
+
+// pruneReplaces removes local replace directives from m that point at
+// modules no longer present in reachable. Paths in excluded are left alone,
+// since they're explicitly opted out of crosslink management rather than
+// simply stale.
+func pruneReplaces(m *moduleInfo, reachable map[string]*moduleInfo, excluded map[string]struct{}) {
+	for _, r := range m.file.Replace {
+		if _, ok := reachable[r.Old.Path]; ok {
+			continue
+		}
+		if _, ok := excluded[r.Old.Path]; ok {
+			continue
+		}
+		if r.New.Version != "" {
+			// Not a local directory replace; leave it alone.
+			continue
+		}
+		_ = m.file.DropReplace(r.Old.Path, r.Old.Version)
+	}
+}
+
+// writeGoMod formats and writes m's go.mod back to disk.
+func writeGoMod(m *moduleInfo) error {
+	data, err := m.file.Format()
+	if err != nil {
+		return fmt.Errorf("failed to format go.mod for %s: %w", m.modPath, err)
+	}
+	// #nosec G306 -- go.mod files are not sensitive and must remain readable.
+	return os.WriteFile(m.goModPath, data, 0o644)
+}
@@ -30,6 +30,65 @@ import (
 	"golang.org/x/mod/modfile"
 )
 
+// gatherActual reads the go.mod files listed in expected off disk. Keys may
+// be absolute paths or paths relative to tmpRootDir; the result is keyed the
+// same way as expected.
+func gatherActual(t *testing.T, tmpRootDir string, expected map[string][]byte) map[string][]byte {
+	t.Helper()
+
+	actual := make(map[string][]byte, len(expected))
+	for modFilePath := range expected {
+		p := modFilePath
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(tmpRootDir, p)
+		}
+		data, err := os.ReadFile(filepath.Clean(p))
+		if err != nil {
+			t.Fatalf("error reading actual mod files: %v", err)
+		}
+		actual[modFilePath] = data
+	}
+	return actual
+}
+
+// assertGoModsMatch compares the go.mod files read into actual against the
+// parsed form of expected, ignoring formatting and the existing-require
+// lists modfile tracks in Require/Exclude/Retract.
+func assertGoModsMatch(t *testing.T, actual, expected map[string][]byte) {
+	t.Helper()
+
+	for modFilePath, modFileExpected := range expected {
+		modFileActual, ok := actual[modFilePath]
+		if !ok {
+			t.Fatalf("no actual go.mod captured for %s", modFilePath)
+		}
+
+		got, err := modfile.Parse("go.mod", modFileActual, nil)
+		if err != nil {
+			t.Fatalf("error decoding original mod files: %v", err)
+		}
+		got.Cleanup()
+
+		want, err := modfile.Parse("go.mod", modFileExpected, nil)
+		if err != nil {
+			t.Fatalf("error decoding expected mod file: %v", err)
+		}
+		want.Cleanup()
+
+		// replace structs need to be sorted to avoid flaky fails in test
+		replaceSortFunc := func(x, y *modfile.Replace) bool {
+			return x.Old.Path < y.Old.Path
+		}
+
+		if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(modfile.Replace{}, "Syntax"),
+			cmpopts.IgnoreFields(modfile.File{}, "Require", "Exclude", "Retract", "Syntax"),
+			cmpopts.SortSlices(replaceSortFunc),
+		); diff != "" {
+			t.Errorf("Replace{} mismatch for %s (-want +got):\n%s", modFilePath, diff)
+		}
+	}
+}
+
 func TestCrosslink(t *testing.T) {
 	lg, _ := zap.NewDevelopment()
 
@@ -37,57 +96,16 @@ func TestCrosslink(t *testing.T) {
 		testName string
 		mockDir  string
 		config   RunConfig
-		expected map[string][]byte
 	}{
 		{
 			testName: "testSimple",
 			mockDir:  "testSimple",
 			config:   DefaultRunConfig(),
-			expected: map[string][]byte{
-				"go.mod": []byte("module go.opentelemetry.io/build-tools/crosslink/testroot\n\n" +
-					"go 1.20\n\n" +
-					"require (\n\t" +
-					"go.opentelemetry.io/build-tools/crosslink/testroot/testA v1.0.0\n" +
-					")\n" +
-					"replace go.opentelemetry.io/build-tools/crosslink/testroot/testA => ./testA\n\n" +
-					"replace go.opentelemetry.io/build-tools/crosslink/testroot/testY => ./testY\n\n" +
-					"replace go.opentelemetry.io/build-tools/crosslink/testroot/testZ => ./testZ\n\n" +
-					"replace go.opentelemetry.io/build-tools/crosslink/testroot/testB => ./testB"),
-				filepath.Join("testA", "go.mod"): []byte("module go.opentelemetry.io/build-tools/crosslink/testroot/testA\n\n" +
-					"go 1.20\n\n" +
-					"require (\n\t" +
-					"go.opentelemetry.io/build-tools/crosslink/testroot/testB v1.0.0\n" +
-					")\n" +
-					"replace go.opentelemetry.io/build-tools/crosslink/testroot/testB => ../testB"),
-				filepath.Join("testB", "go.mod"): []byte("module go.opentelemetry.io/build-tools/crosslink/testroot/testB\n\n" +
-					"go 1.20\n\n"),
-			},
 		},
 		{
 			testName: "testCyclic",
 			mockDir:  "testCyclic",
 			config:   DefaultRunConfig(),
-			expected: map[string][]byte{
-				"go.mod": []byte("module go.opentelemetry.io/build-tools/crosslink/testroot\n\n" +
-					"go 1.20\n\n" +
-					"require (\n\t" +
-					"go.opentelemetry.io/build-tools/crosslink/testroot/testA v1.0.0\n" +
-					")\n" +
-					"replace go.opentelemetry.io/build-tools/crosslink/testroot/testA => ./testA\n\n" +
-					"replace go.opentelemetry.io/build-tools/crosslink/testroot/testB => ./testB"),
-				filepath.Join("testA", "go.mod"): []byte("module go.opentelemetry.io/build-tools/crosslink/testroot/testA\n\n" +
-					"go 1.20\n\n" +
-					"require (\n\t" +
-					"go.opentelemetry.io/build-tools/crosslink/testroot/testB v1.0.0\n" +
-					")\n" +
-					"replace go.opentelemetry.io/build-tools/crosslink/testroot/testB => ../testB\n\n" +
-					"replace go.opentelemetry.io/build-tools/crosslink/testroot => ../"),
-				// b has req on root but not necessary to write out with current comparison logic
-				filepath.Join("testB", "go.mod"): []byte("module go.opentelemetry.io/build-tools/crosslink/testroot/testB\n\n" +
-					"go 1.20\n\n" +
-					"replace go.opentelemetry.io/build-tools/crosslink/testroot/testA => ../testA\n\n" +
-					"replace go.opentelemetry.io/build-tools/crosslink/testroot => ../\n\n"),
-			},
 		},
 		{
 			testName: "testSimpleWithPrune",
@@ -96,23 +114,6 @@ func TestCrosslink(t *testing.T) {
 				Prune:  true,
 				Logger: lg,
 			},
-			expected: map[string][]byte{
-				"go.mod": []byte("module go.opentelemetry.io/build-tools/crosslink/testroot\n\n" +
-					"go 1.20\n\n" +
-					"require (\n\t" +
-					"go.opentelemetry.io/build-tools/crosslink/testroot/testA v1.0.0\n" +
-					")\n" +
-					"replace go.opentelemetry.io/build-tools/crosslink/testroot/testA => ./testA\n\n" +
-					"replace go.opentelemetry.io/build-tools/crosslink/testroot/testB => ./testB"),
-				filepath.Join("testA", "go.mod"): []byte("module go.opentelemetry.io/build-tools/crosslink/testroot/testA\n\n" +
-					"go 1.20\n\n" +
-					"require (\n\t" +
-					"go.opentelemetry.io/build-tools/crosslink/testroot/testB v1.0.0\n" +
-					")\n" +
-					"replace go.opentelemetry.io/build-tools/crosslink/testroot/testB => ../testB"),
-				filepath.Join("testB", "go.mod"): []byte("module go.opentelemetry.io/build-tools/crosslink/testroot/testB\n\n" +
-					"go 1.20\n\n"),
-			},
 		},
 	}
 
@@ -129,36 +130,13 @@ func TestCrosslink(t *testing.T) {
 			err = Crosslink(test.config)
 
 			if assert.NoError(t, err, "error message on execution %s") {
-				for modFilePath, modFilesExpected := range test.expected {
-					modFileActual, err := os.ReadFile(filepath.Clean(filepath.Join(tmpRootDir, modFilePath)))
-					if err != nil {
-						t.Fatalf("error reading actual mod files: %v", err)
-					}
-
-					actual, err := modfile.Parse("go.mod", modFileActual, nil)
-					if err != nil {
-						t.Fatalf("error decoding original mod files: %v", err)
-					}
-					actual.Cleanup()
-
-					expected, err := modfile.Parse("go.mod", modFilesExpected, nil)
-					if err != nil {
-						t.Fatalf("error decoding expected mod file: %v", err)
-					}
-					expected.Cleanup()
-
-					// replace structs need to be assorted to avoid flaky fails in test
-					replaceSortFunc := func(x, y *modfile.Replace) bool {
-						return x.Old.Path < y.Old.Path
-					}
-
-					if diff := cmp.Diff(expected, actual, cmpopts.IgnoreFields(modfile.Replace{}, "Syntax"),
-						cmpopts.IgnoreFields(modfile.File{}, "Require", "Exclude", "Retract", "Syntax"),
-						cmpopts.SortSlices(replaceSortFunc),
-					); diff != "" {
-						t.Errorf("Replace{} mismatch (-want +got):\n%s", diff)
-					}
+				expected := loadExpected(t, test.testName)
+				actual := gatherActual(t, tmpRootDir, expected)
+				updateExpected(t, test.testName, actual)
+				if *update {
+					return
 				}
+				assertGoModsMatch(t, actual, expected)
 			}
 		})
 	}
@@ -170,7 +148,6 @@ func TestOverwrite(t *testing.T) {
 	tests := []struct {
 		testName string
 		config   RunConfig
-		expected map[string][]byte
 	}{
 		{
 			testName: "testOverwrite",
@@ -180,23 +157,6 @@ func TestOverwrite(t *testing.T) {
 				ExcludedPaths: map[string]struct{}{},
 				Logger:        lg,
 			},
-			expected: map[string][]byte{
-				"go.mod": []byte("module go.opentelemetry.io/build-tools/crosslink/testroot\n\n" +
-					"go 1.20\n\n" +
-					"require (\n\t" +
-					"go.opentelemetry.io/build-tools/crosslink/testroot/testA v1.0.0\n" +
-					")\n" +
-					"replace go.opentelemetry.io/build-tools/crosslink/testroot/testA => ./testA\n\n" +
-					"replace go.opentelemetry.io/build-tools/crosslink/testroot/testB => ./testB"),
-				filepath.Join("testA", "go.mod"): []byte("module go.opentelemetry.io/build-tools/crosslink/testroot/testA\n\n" +
-					"go 1.20\n\n" +
-					"require (\n\t" +
-					"go.opentelemetry.io/build-tools/crosslink/testroot/testB v1.0.0\n" +
-					")\n" +
-					"replace go.opentelemetry.io/build-tools/crosslink/testroot/testB => ../testB"),
-				filepath.Join("testB", "go.mod"): []byte("module go.opentelemetry.io/build-tools/crosslink/testroot/testB\n\n" +
-					"go 1.20\n\n"),
-			},
 		},
 		{
 			testName: "testNoOverwrite",
@@ -205,23 +165,6 @@ func TestOverwrite(t *testing.T) {
 				Verbose:       true,
 				Logger:        lg,
 			},
-			expected: map[string][]byte{
-				"go.mod": []byte("module go.opentelemetry.io/build-tools/crosslink/testroot\n\n" +
-					"go 1.20\n\n" +
-					"require (\n\t" +
-					"go.opentelemetry.io/build-tools/crosslink/testroot/testA v1.0.0\n" +
-					")\n" +
-					"replace go.opentelemetry.io/build-tools/crosslink/testroot/testA => ../testA\n\n" +
-					"replace go.opentelemetry.io/build-tools/crosslink/testroot/testB => ./testB"),
-				filepath.Join("testA", "go.mod"): []byte("module go.opentelemetry.io/build-tools/crosslink/testroot/testA\n\n" +
-					"go 1.20\n\n" +
-					"require (\n\t" +
-					"go.opentelemetry.io/build-tools/crosslink/testroot/testB v1.0.0\n" +
-					")\n" +
-					"replace go.opentelemetry.io/build-tools/crosslink/testroot/testB => ../testB"),
-				filepath.Join("testB", "go.mod"): []byte("module go.opentelemetry.io/build-tools/crosslink/testroot/testB\n\n" +
-					"go 1.20\n\n"),
-			},
 		},
 	}
 
@@ -238,38 +181,13 @@ func TestOverwrite(t *testing.T) {
 			err = Crosslink(test.config)
 
 			if assert.NoError(t, err, "error message on execution %s") {
-				// a mock_test_data_expected folder could be built instead of building expected files by hand.
-
-				for modFilePath, modFilesExpected := range test.expected {
-					modFileActual, err := os.ReadFile(filepath.Clean(filepath.Join(tmpRootDir, modFilePath)))
-					if err != nil {
-						t.Fatalf("error reading actual mod files: %v", err)
-					}
-
-					actual, err := modfile.Parse("go.mod", modFileActual, nil)
-					if err != nil {
-						t.Fatalf("error decoding original mod files: %v", err)
-					}
-					actual.Cleanup()
-
-					expected, err := modfile.Parse("go.mod", modFilesExpected, nil)
-					if err != nil {
-						t.Fatalf("error decoding expected mod file: %v", err)
-					}
-					expected.Cleanup()
-
-					// replace structs need to be assorted to avoid flaky fails in test
-					replaceSortFunc := func(x, y *modfile.Replace) bool {
-						return x.Old.Path < y.Old.Path
-					}
-
-					if diff := cmp.Diff(expected, actual, cmpopts.IgnoreFields(modfile.Replace{}, "Syntax"),
-						cmpopts.IgnoreFields(modfile.File{}, "Require", "Exclude", "Retract", "Syntax"),
-						cmpopts.SortSlices(replaceSortFunc),
-					); diff != "" {
-						t.Errorf("Replace{} mismatch (-want +got):\n%s", diff)
-					}
+				expected := loadExpected(t, test.testName)
+				actual := gatherActual(t, tmpRootDir, expected)
+				updateExpected(t, test.testName, actual)
+				if *update {
+					return
 				}
+				assertGoModsMatch(t, actual, expected)
 			}
 		})
 	}
@@ -329,55 +247,26 @@ func TestExclude(t *testing.T) {
 			err = Crosslink(test.config)
 
 			if assert.NoError(t, err, "error message on execution %s") {
-				// a mock_test_data_expected folder could be built instead of building expected files by hand.
-				modFilesExpected := map[string][]byte{
-					filepath.Join(tmpRootDir, "go.mod"): []byte("module go.opentelemetry.io/build-tools/crosslink/testroot\n\n" +
-						"go 1.20\n\n" +
-						"require (\n\t" +
-						"go.opentelemetry.io/build-tools/crosslink/testroot/testA v1.0.0\n" +
-						")\n" +
-						"replace go.opentelemetry.io/build-tools/crosslink/testroot/testA => ../testA\n\n" +
-						"replace go.opentelemetry.io/build-tools/excludeme => ../excludeme\n\n"),
-					filepath.Join(tmpRootDir, "testA", "go.mod"): []byte("module go.opentelemetry.io/build-tools/crosslink/testroot/testA\n\n" +
-						"go 1.20\n\n" +
-						"require (\n\t" +
-						"go.opentelemetry.io/build-tools/crosslink/testroot/testB v1.0.0\n" +
-						")\n" +
-						"replace go.opentelemetry.io/build-tools/crosslink/testroot/testB => ../testB"),
-					filepath.Join(tmpRootDir, "testB", "go.mod"): []byte("module go.opentelemetry.io/build-tools/crosslink/testroot/testB\n\n" +
-						"go 1.20\n\n"),
+				expected := loadExpected(t, testName)
+				absExpected := make(map[string][]byte, len(expected))
+				for p, data := range expected {
+					absExpected[filepath.Join(tmpRootDir, p)] = data
 				}
 
-				for modFilePath, modFilesExpected := range modFilesExpected {
-					modFileActual, err := os.ReadFile(filepath.Clean(modFilePath))
-					if err != nil {
-						t.Fatalf("TestCase: %s, error reading actual mod files: %v", test.testCase, err)
-					}
-
-					actual, err := modfile.Parse("go.mod", modFileActual, nil)
-					if err != nil {
-						t.Fatalf("error decoding original mod files: %v", err)
-					}
-					actual.Cleanup()
-
-					expected, err := modfile.Parse("go.mod", modFilesExpected, nil)
+				actual := gatherActual(t, tmpRootDir, absExpected)
+				relActual := make(map[string][]byte, len(actual))
+				for p, data := range actual {
+					rel, err := filepath.Rel(tmpRootDir, p)
 					if err != nil {
-						t.Fatalf("TestCase: %s ,error decoding expected mod file: %v", test.testCase, err)
-					}
-					expected.Cleanup()
-
-					// replace structs need to be assorted to avoid flaky fails in test
-					replaceSortFunc := func(x, y *modfile.Replace) bool {
-						return x.Old.Path < y.Old.Path
-					}
-
-					if diff := cmp.Diff(expected, actual, cmpopts.IgnoreFields(modfile.Replace{}, "Syntax"),
-						cmpopts.IgnoreFields(modfile.File{}, "Require", "Exclude", "Retract", "Syntax"),
-						cmpopts.SortSlices(replaceSortFunc),
-					); diff != "" {
-						t.Errorf("TestCase: %s \n Replace{} mismatch (-want +got):\n%s", test.testCase, diff)
+						t.Fatalf("error computing relative path: %v", err)
 					}
+					relActual[filepath.ToSlash(rel)] = data
 				}
+				updateExpected(t, testName, relActual)
+				if *update {
+					return
+				}
+				assertGoModsMatch(t, actual, absExpected)
 			}
 		})
 	}
@@ -454,6 +343,8 @@ func TestSkip(t *testing.T) {
 		},
 	}
 
+	expected := loadExpected(t, testName)
+
 	for _, test := range tests {
 		t.Run(test.testCase, func(t *testing.T) {
 			tmpRootDir := createTempTestDir(t, testName)
@@ -467,30 +358,16 @@ func TestSkip(t *testing.T) {
 			err = Crosslink(test.config)
 			require.NoError(t, err, "error message on execution %s")
 
-			modFilesExpected := map[string][]byte{
-				filepath.Join(tmpRootDir, "go.mod"): []byte("module go.opentelemetry.io/build-tools/crosslink/testroot\n\n" +
-					"go 1.20\n\n" +
-					"require (\n\t" +
-					"go.opentelemetry.io/build-tools/crosslink/testroot/testA v1.0.0\n" +
-					")\n" +
-					"replace go.opentelemetry.io/build-tools/crosslink/testroot/testA => ./testA\n\n" +
-					"replace go.opentelemetry.io/build-tools/excludeme => ../excludeme\n\n"),
-				filepath.Join(tmpRootDir, "testA", "go.mod"): []byte("module go.opentelemetry.io/build-tools/crosslink/testroot/testA\n\n" +
-					"go 1.20\n\n" +
-					"require (\n\t" +
-					"go.opentelemetry.io/build-tools/crosslink/testroot/testB v1.0.0\n" +
-					")\n" +
-					"replace go.opentelemetry.io/build-tools/crosslink/testroot/testB => ../testB"),
-			}
+			for modFilePath, modFileExpected := range expected {
+				absPath := filepath.Join(tmpRootDir, modFilePath)
 
-			for modFilePath, modFilesExpected := range modFilesExpected {
 				shouldDiffer := false
 				for path := range test.config.SkippedPaths {
-					if strings.HasSuffix(modFilePath, path) {
+					if strings.HasSuffix(absPath, path) {
 						shouldDiffer = true
 					}
 				}
-				modFileActual, err := os.ReadFile(filepath.Clean(modFilePath))
+				modFileActual, err := os.ReadFile(filepath.Clean(absPath))
 				if err != nil {
 					t.Fatalf("TestCase: %s, error reading actual mod files: %v", test.testCase, err)
 				}
@@ -501,18 +378,17 @@ func TestSkip(t *testing.T) {
 				}
 				actual.Cleanup()
 
-				expected, err := modfile.Parse("go.mod", modFilesExpected, nil)
+				want, err := modfile.Parse("go.mod", modFileExpected, nil)
 				if err != nil {
 					t.Fatalf("TestCase: %s ,error decoding expected mod file: %v", test.testCase, err)
 				}
-				expected.Cleanup()
+				want.Cleanup()
 
-				// replace structs need to be assorted to avoid flaky fails in test
 				replaceSortFunc := func(x, y *modfile.Replace) bool {
 					return x.Old.Path < y.Old.Path
 				}
 
-				if diff := cmp.Diff(expected, actual, cmpopts.IgnoreFields(modfile.Replace{}, "Syntax"),
+				if diff := cmp.Diff(want, actual, cmpopts.IgnoreFields(modfile.Replace{}, "Syntax"),
 					cmpopts.IgnoreFields(modfile.File{}, "Require", "Exclude", "Retract", "Syntax"),
 					cmpopts.SortSlices(replaceSortFunc),
 				); diff != "" && shouldDiffer {
@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crosslink
+
+import (
+	"os"
+	"path/filepath"
+
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/build-tools/crosslink/internal/levenshtein"
+)
+
+// warnUnmatchedFilters logs a warning for every entry of cfg.ExcludedPaths
+// and cfg.SkippedPaths that didn't match any module discovered under
+// cfg.RootPath, suggesting the closest candidates so a typo'd filter isn't
+// silently ignored.
+func warnUnmatchedFilters(cfg RunConfig, mods []*moduleInfo) {
+	modPaths := make([]string, 0, len(mods))
+	for _, m := range mods {
+		modPaths = append(modPaths, m.modPath)
+	}
+
+	goModPaths, err := allGoModPaths(cfg.RootPath)
+	if err != nil {
+		cfg.Logger.Warn("failed to list go.mod paths for typo suggestions", zap.Error(err))
+		goModPaths = nil
+	}
+
+	for query := range cfg.ExcludedPaths {
+		warnIfUnmatched(cfg, "ExcludedPaths", query, modPaths)
+	}
+	for query := range cfg.SkippedPaths {
+		warnIfUnmatched(cfg, "SkippedPaths", query, goModPaths)
+	}
+}
+
+// warnIfUnmatched logs a warning naming the closest entries in candidates
+// when query isn't one of them.
+func warnIfUnmatched(cfg RunConfig, field, query string, candidates []string) {
+	for _, c := range candidates {
+		if c == query {
+			return
+		}
+	}
+
+	maxDistance := len(query) / 3
+	if maxDistance < 5 {
+		maxDistance = 5
+	}
+
+	suggestions := levenshtein.Closest(query, candidates, maxDistance)
+	if len(suggestions) == 0 {
+		cfg.Logger.Warn("filter did not match any module in the tree",
+			zap.String("field", field), zap.String("value", query))
+		return
+	}
+
+	cfg.Logger.Warn("filter did not match any module in the tree, did you mean one of these?",
+		zap.String("field", field), zap.String("value", query), zap.Strings("candidates", suggestions))
+}
+
+// allGoModPaths walks rootPath and returns the path of every go.mod found,
+// relative to rootPath, regardless of SkippedPaths.
+func allGoModPaths(rootPath string) ([]string, error) {
+	var paths []string
+	err := filepath.Walk(rootPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Base(path) != "go.mod" {
+			return nil
+		}
+		rel, err := filepath.Rel(rootPath, path)
+		if err != nil {
+			return err
+		}
+		paths = append(paths, filepath.ToSlash(rel))
+		return nil
+	})
+	return paths, err
+}
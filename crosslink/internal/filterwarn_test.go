@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crosslink
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestWarnUnmatchedFilters(t *testing.T) {
+	core, logs := observer.New(zap.WarnLevel)
+	lg := zap.New(core)
+
+	tmpRootDir := createTempTestDir(t, "testSimple")
+	require.NoError(t, renameGoMod(tmpRootDir))
+
+	cfg := RunConfig{
+		RootPath: tmpRootDir,
+		ExcludedPaths: map[string]struct{}{
+			// Typo: missing the trailing "A".
+			"go.opentelemetry.io/build-tools/crosslink/testroot/test": {},
+		},
+		SkippedPaths: map[string]struct{}{},
+		Logger:       lg,
+	}
+
+	require.NoError(t, Crosslink(cfg))
+
+	entries := logs.FilterMessageSnippet("did you mean").All()
+	require.Len(t, entries, 1)
+
+	candidates, ok := entries[0].ContextMap()["candidates"].([]interface{})
+	require.True(t, ok)
+	assert.Contains(t, candidates, "go.opentelemetry.io/build-tools/crosslink/testroot/testA")
+}
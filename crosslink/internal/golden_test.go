@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crosslink
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/txtar"
+)
+
+// update, when passed as `-update`, regenerates the txtar golden files under
+// testdata/expected from the tree produced by the test that ran, instead of
+// comparing against them. Mirrors the convention used by cmd/go's
+// script_test.go.
+var update = flag.Bool("update", false, "update golden txtar fixtures in testdata/expected")
+
+// goldenPath returns the path to the txtar fixture for the named test.
+func goldenPath(name string) string {
+	return filepath.Join("testdata", "expected", name+".txtar")
+}
+
+// loadExpected parses the txtar archive at testdata/expected/<name>.txtar
+// into a map of file path (relative to the module root) to expected
+// contents.
+func loadExpected(t *testing.T, name string) map[string][]byte {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Clean(goldenPath(name)))
+	require.NoError(t, err)
+
+	arc := txtar.Parse(data)
+	expected := make(map[string][]byte, len(arc.Files))
+	for _, f := range arc.Files {
+		expected[filepath.FromSlash(f.Name)] = f.Data
+	}
+	return expected
+}
+
+// updateExpected regenerates the txtar archive for name from actual, the
+// files produced by the test that ran, when the -update flag is set.
+func updateExpected(t *testing.T, name string, actual map[string][]byte) {
+	t.Helper()
+
+	if !*update {
+		return
+	}
+
+	arc := &txtar.Archive{}
+	for path, data := range actual {
+		arc.Files = append(arc.Files, txtar.File{Name: filepath.ToSlash(path), Data: data})
+	}
+
+	// #nosec G306 -- golden fixtures are not sensitive and must remain readable.
+	require.NoError(t, os.WriteFile(goldenPath(name), txtar.Format(arc), 0o644))
+}
@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package levenshtein provides a small helper for suggesting close matches
+// to a misspelled identifier, e.g. a module path that doesn't resolve to
+// anything in the tree.
+package levenshtein
+
+import "sort"
+
+// Distance returns the Levenshtein edit distance between a and b, computed
+// with the standard iterative two-row dynamic program: O(len(a)*len(b))
+// time, O(min(len(a), len(b))) space.
+func Distance(a, b string) int {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	if len(b) == 0 {
+		return len(a)
+	}
+
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min3(
+				prev[j]+1,      // deletion
+				curr[j-1]+1,    // insertion
+				prev[j-1]+cost, // substitution
+			)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
+
+func min3(a, b, c int) int {
+	m := a
+	if b < m {
+		m = b
+	}
+	if c < m {
+		m = c
+	}
+	return m
+}
+
+// Closest returns up to 3 of candidates within maxDistance of query, ordered
+// from closest to furthest. Ties are broken by the order candidates were
+// given. It returns nil if no candidate is within maxDistance.
+func Closest(query string, candidates []string, maxDistance int) []string {
+	type scored struct {
+		candidate string
+		distance  int
+		index     int
+	}
+
+	var matches []scored
+	for i, c := range candidates {
+		if d := Distance(query, c); d <= maxDistance {
+			matches = append(matches, scored{candidate: c, distance: d, index: i})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].distance != matches[j].distance {
+			return matches[i].distance < matches[j].distance
+		}
+		return matches[i].index < matches[j].index
+	})
+
+	const maxSuggestions = 3
+	if len(matches) > maxSuggestions {
+		matches = matches[:maxSuggestions]
+	}
+
+	if len(matches) == 0 {
+		return nil
+	}
+
+	out := make([]string, len(matches))
+	for i, m := range matches {
+		out[i] = m.candidate
+	}
+	return out
+}
@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package levenshtein
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"kitten", "sitting", 3},
+		{"testA", "testA", 0},
+		{"excludeme", "excludeme", 0},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.want, Distance(test.a, test.b), "Distance(%q, %q)", test.a, test.b)
+	}
+}
+
+func TestClosest(t *testing.T) {
+	candidates := []string{
+		"go.opentelemetry.io/build-tools/crosslink/testroot/testA",
+		"go.opentelemetry.io/build-tools/crosslink/testroot/testB",
+		"go.opentelemetry.io/build-tools/crosslink/testroot",
+	}
+
+	got := Closest("go.opentelemetry.io/build-tools/excludeme", candidates, 5)
+	assert.Empty(t, got)
+
+	got = Closest("go.opentelemetry.io/build-tools/crosslink/testroot/testAA", candidates, 5)
+	assert.Equal(t, []string{
+		"go.opentelemetry.io/build-tools/crosslink/testroot/testA",
+		"go.opentelemetry.io/build-tools/crosslink/testroot/testB",
+	}, got)
+}
@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dep converts Gopkg.lock files, produced by the golang/dep
+// dependency manager, into module versions.
+package dep
+
+import (
+	"strings"
+
+	"golang.org/x/mod/module"
+)
+
+// Convert parses the TOML body of a Gopkg.lock file and returns the pinned
+// version of each [[projects]] entry. The version preferred is, in order,
+// the "version" field, falling back to "revision" when no version is
+// pinned, matching how dep itself resolves a project.
+func Convert(data []byte) ([]module.Version, error) {
+	var (
+		versions   []module.Version
+		name, vers string
+		inProject  bool
+	)
+
+	flush := func() {
+		if name == "" {
+			return
+		}
+		versions = append(versions, module.Version{Path: name, Version: vers})
+		name, vers = "", ""
+	}
+
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "[[projects]]":
+			flush()
+			inProject = true
+		case strings.HasPrefix(line, "["):
+			flush()
+			inProject = false
+		case inProject && strings.HasPrefix(line, "name"):
+			name = tomlValue(line)
+		case inProject && strings.HasPrefix(line, "version"):
+			vers = tomlValue(line)
+		case inProject && vers == "" && strings.HasPrefix(line, "revision"):
+			vers = tomlValue(line)
+		}
+	}
+	flush()
+
+	return versions, nil
+}
+
+// tomlValue extracts the quoted value from a `key = "value"` TOML line.
+func tomlValue(line string) string {
+	i := strings.IndexByte(line, '=')
+	if i < 0 {
+		return ""
+	}
+	return strings.Trim(strings.TrimSpace(line[i+1:]), `"`)
+}
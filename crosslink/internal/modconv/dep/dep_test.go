@@ -0,0 +1,47 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dep
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/mod/module"
+)
+
+func TestConvert(t *testing.T) {
+	lock := []byte(`
+[[projects]]
+  name = "github.com/pkg/errors"
+  packages = ["."]
+  revision = "614d223910a179a466c1767a985424175c39b465"
+  version = "v0.9.1"
+
+[[projects]]
+  name = "golang.org/x/sync"
+  packages = ["errgroup"]
+  revision = "cd5d95a43a6e21273425c7ae415d3df9ea832eeb"
+
+[solve-meta]
+  inputs-digest = "abc123"
+`)
+
+	got, err := Convert(lock)
+	assert.NoError(t, err)
+	assert.Equal(t, []module.Version{
+		{Path: "github.com/pkg/errors", Version: "v0.9.1"},
+		{Path: "golang.org/x/sync", Version: "cd5d95a43a6e21273425c7ae415d3df9ea832eeb"},
+	}, got)
+}
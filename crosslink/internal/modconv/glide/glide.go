@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package glide converts glide.lock files, produced by the Masterminds/glide
+// dependency manager, into module versions.
+package glide
+
+import (
+	"fmt"
+
+	"golang.org/x/mod/module"
+	"gopkg.in/yaml.v3"
+)
+
+// lockFile mirrors the subset of glide.lock fields crosslink cares about.
+type lockFile struct {
+	Imports     []importedPackage `yaml:"imports"`
+	TestImports []importedPackage `yaml:"testImports"`
+}
+
+type importedPackage struct {
+	Name    string `yaml:"name"`
+	Version string `yaml:"version"`
+}
+
+// Convert parses the YAML body of a glide.lock file and returns the pinned
+// version of every imported and test-imported package.
+func Convert(data []byte) ([]module.Version, error) {
+	var lock lockFile
+	if err := yaml.Unmarshal(data, &lock); err != nil {
+		return nil, fmt.Errorf("failed to parse glide.lock: %w", err)
+	}
+
+	var versions []module.Version
+	for _, pkgs := range [][]importedPackage{lock.Imports, lock.TestImports} {
+		for _, p := range pkgs {
+			versions = append(versions, module.Version{Path: p.Name, Version: p.Version})
+		}
+	}
+	return versions, nil
+}
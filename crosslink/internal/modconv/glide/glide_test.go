@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glide
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/mod/module"
+)
+
+func TestConvert(t *testing.T) {
+	lock := []byte(`
+imports:
+- name: github.com/pkg/errors
+  version: v0.9.1
+testImports:
+- name: github.com/stretchr/testify
+  version: v1.7.0
+`)
+
+	got, err := Convert(lock)
+	assert.NoError(t, err)
+	assert.Equal(t, []module.Version{
+		{Path: "github.com/pkg/errors", Version: "v0.9.1"},
+		{Path: "github.com/stretchr/testify", Version: "v1.7.0"},
+	}, got)
+}
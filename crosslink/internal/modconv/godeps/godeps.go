@@ -0,0 +1,47 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package godeps converts Godeps/Godeps.json files, produced by the
+// tools/godep dependency manager, into module versions.
+package godeps
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"golang.org/x/mod/module"
+)
+
+// godepsFile mirrors the subset of Godeps.json fields crosslink cares about.
+type godepsFile struct {
+	Deps []struct {
+		ImportPath string `json:"ImportPath"`
+		Rev        string `json:"Rev"`
+	} `json:"Deps"`
+}
+
+// Convert parses the JSON body of a Godeps/Godeps.json file and returns the
+// pinned revision of every dependency it declares.
+func Convert(data []byte) ([]module.Version, error) {
+	var gf godepsFile
+	if err := json.Unmarshal(data, &gf); err != nil {
+		return nil, fmt.Errorf("failed to parse Godeps.json: %w", err)
+	}
+
+	versions := make([]module.Version, 0, len(gf.Deps))
+	for _, d := range gf.Deps {
+		versions = append(versions, module.Version{Path: d.ImportPath, Version: d.Rev})
+	}
+	return versions, nil
+}
@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package godeps
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/mod/module"
+)
+
+func TestConvert(t *testing.T) {
+	file := []byte(`{
+  "ImportPath": "example.com/app",
+  "GoVersion": "go1.9",
+  "Deps": [
+    {
+      "ImportPath": "github.com/pkg/errors",
+      "Rev": "614d223910a179a466c1767a985424175c39b465"
+    },
+    {
+      "ImportPath": "golang.org/x/sync/errgroup",
+      "Rev": "cd5d95a43a6e21273425c7ae415d3df9ea832eeb"
+    }
+  ]
+}`)
+
+	got, err := Convert(file)
+	assert.NoError(t, err)
+	assert.Equal(t, []module.Version{
+		{Path: "github.com/pkg/errors", Version: "614d223910a179a466c1767a985424175c39b465"},
+		{Path: "golang.org/x/sync/errgroup", Version: "cd5d95a43a6e21273425c7ae415d3df9ea832eeb"},
+	}, got)
+}
@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package modconv converts legacy, pre-modules Go dependency manifests into
+// the require stanza of a go.mod file.
+package modconv
+
+import (
+	"golang.org/x/mod/module"
+
+	"go.opentelemetry.io/build-tools/crosslink/internal/modconv/dep"
+	"go.opentelemetry.io/build-tools/crosslink/internal/modconv/glide"
+	"go.opentelemetry.io/build-tools/crosslink/internal/modconv/godeps"
+	"go.opentelemetry.io/build-tools/crosslink/internal/modconv/vendorjson"
+	"go.opentelemetry.io/build-tools/crosslink/internal/modconv/vendormanifest"
+)
+
+// Converter parses the contents of a legacy manifest file and returns the
+// pinned module versions it declares.
+type Converter func(data []byte) ([]module.Version, error)
+
+// Registry maps the path of a legacy manifest, relative to the module
+// directory it lives in, to the Converter that understands it. New formats
+// can be supported by registering an additional entry here.
+var Registry = map[string]Converter{
+	"Gopkg.lock":         dep.Convert,
+	"glide.lock":         glide.Convert,
+	"Godeps/Godeps.json": godeps.Convert,
+	"vendor/vendor.json": vendorjson.Convert,
+	"vendor/manifest":    vendormanifest.Convert,
+}
+
+// RegistryOrder lists the keys of Registry in the order callers should try
+// them, so that a module with more than one legacy manifest present is
+// converted deterministically rather than depending on Go's randomized map
+// iteration order.
+var RegistryOrder = []string{
+	"Gopkg.lock",
+	"glide.lock",
+	"Godeps/Godeps.json",
+	"vendor/vendor.json",
+	"vendor/manifest",
+}
@@ -0,0 +1,47 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vendorjson converts vendor/vendor.json files, produced by the
+// govendor dependency manager, into module versions.
+package vendorjson
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"golang.org/x/mod/module"
+)
+
+// vendorFile mirrors the subset of vendor.json fields crosslink cares about.
+type vendorFile struct {
+	Package []struct {
+		Path     string `json:"path"`
+		Revision string `json:"revision"`
+	} `json:"package"`
+}
+
+// Convert parses the JSON body of a vendor/vendor.json file and returns the
+// pinned revision of every vendored package.
+func Convert(data []byte) ([]module.Version, error) {
+	var vf vendorFile
+	if err := json.Unmarshal(data, &vf); err != nil {
+		return nil, fmt.Errorf("failed to parse vendor.json: %w", err)
+	}
+
+	versions := make([]module.Version, 0, len(vf.Package))
+	for _, p := range vf.Package {
+		versions = append(versions, module.Version{Path: p.Path, Version: p.Revision})
+	}
+	return versions, nil
+}
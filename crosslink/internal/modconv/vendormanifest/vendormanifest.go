@@ -0,0 +1,48 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vendormanifest converts vendor/manifest files, produced by the gb
+// vendor plugin, into module versions.
+package vendormanifest
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"golang.org/x/mod/module"
+)
+
+// manifestFile mirrors the subset of vendor/manifest fields crosslink cares
+// about.
+type manifestFile struct {
+	Dependencies []struct {
+		Importpath string `json:"importpath"`
+		Revision   string `json:"revision"`
+	} `json:"dependencies"`
+}
+
+// Convert parses the JSON body of a vendor/manifest file and returns the
+// pinned revision of every vendored dependency.
+func Convert(data []byte) ([]module.Version, error) {
+	var mf manifestFile
+	if err := json.Unmarshal(data, &mf); err != nil {
+		return nil, fmt.Errorf("failed to parse vendor/manifest: %w", err)
+	}
+
+	versions := make([]module.Version, 0, len(mf.Dependencies))
+	for _, d := range mf.Dependencies {
+		versions = append(versions, module.Version{Path: d.Importpath, Version: d.Revision})
+	}
+	return versions, nil
+}
@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vendormanifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/mod/module"
+)
+
+func TestConvert(t *testing.T) {
+	file := []byte(`{
+  "version": 0,
+  "dependencies": [
+    {
+      "importpath": "github.com/pkg/errors",
+      "repository": "https://github.com/pkg/errors",
+      "revision": "614d223910a179a466c1767a985424175c39b465",
+      "branch": "master"
+    }
+  ]
+}`)
+
+	got, err := Convert(file)
+	assert.NoError(t, err)
+	assert.Equal(t, []module.Version{
+		{Path: "github.com/pkg/errors", Version: "614d223910a179a466c1767a985424175c39b465"},
+	}, got)
+}
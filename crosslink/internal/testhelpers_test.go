@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crosslink
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// goModFixtureName is the name fixture go.mod files are checked in under,
+// so that testdata isn't itself mistaken for a nested module by the
+// surrounding repository. renameGoMod restores the real name once a fixture
+// has been copied into a scratch directory.
+const goModFixtureName = "go.mod.txt"
+
+// createTempTestDir copies the mock module tree at testdata/<mockDir> into a
+// fresh temporary directory and returns its path, so a test can mutate it
+// freely.
+func createTempTestDir(t *testing.T, mockDir string) string {
+	t.Helper()
+
+	src := filepath.Join("testdata", mockDir)
+	dst := t.TempDir()
+
+	err := filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		if info.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+
+		data, err := os.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return err
+		}
+		// #nosec G306 -- test fixtures are not sensitive and must remain readable.
+		return os.WriteFile(target, data, 0o644)
+	})
+	require.NoError(t, err)
+
+	return dst
+}
+
+// renameGoMod walks root renaming every goModFixtureName file to go.mod.
+func renameGoMod(root string) error {
+	return filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Base(path) != goModFixtureName {
+			return nil
+		}
+		return os.Rename(path, filepath.Join(filepath.Dir(path), "go.mod"))
+	})
+}
@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crosslink
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"go.uber.org/zap"
+	"golang.org/x/mod/modfile"
+)
+
+// writeWorkspace discovers the modules rooted at cfg.RootPath and emits a
+// go.work file listing each of them, instead of the per-module replace
+// directives Crosslink would otherwise inject. When cfg.PruneReplaces is
+// set, every local-directory replace directive is stripped from each
+// module's go.mod, whether or not Crosslink injected it.
+func writeWorkspace(cfg RunConfig) error {
+	mods, err := discoverModules(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to discover modules in %s: %w", cfg.RootPath, err)
+	}
+
+	wf, err := modfile.ParseWork("go.work", nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to initialize go.work: %w", err)
+	}
+	if err := wf.AddGoStmt(cfg.WorkspaceGoVersion); err != nil {
+		return fmt.Errorf("failed to set go directive on go.work: %w", err)
+	}
+
+	for _, m := range mods {
+		rel, err := filepath.Rel(cfg.RootPath, m.dir)
+		if err != nil {
+			return fmt.Errorf("failed to compute relative path for %s: %w", m.dir, err)
+		}
+		rel = filepath.ToSlash(rel)
+		if rel[0] != '.' {
+			rel = "./" + rel
+		}
+		if err := wf.AddUse(rel, ""); err != nil {
+			return fmt.Errorf("failed to add use directive for %s: %w", m.modPath, err)
+		}
+
+		if cfg.PruneReplaces {
+			if err := pruneLocalReplaces(m); err != nil {
+				return err
+			}
+		}
+	}
+
+	wf.Cleanup()
+	out := modfile.Format(wf.Syntax)
+
+	// #nosec G306 -- go.work files are not sensitive and must remain readable.
+	if err := os.WriteFile(filepath.Join(cfg.RootPath, "go.work"), out, 0o644); err != nil {
+		return fmt.Errorf("failed to write go.work: %w", err)
+	}
+
+	cfg.Logger.Info("wrote go.work", zap.Int("modules", len(mods)))
+	return nil
+}
+
+// pruneLocalReplaces drops every local-directory replace directive from m,
+// since a go.work file makes them redundant.
+func pruneLocalReplaces(m *moduleInfo) error {
+	for _, r := range m.file.Replace {
+		if r.New.Version != "" {
+			// Not a local directory replace; leave it alone.
+			continue
+		}
+		if err := m.file.DropReplace(r.Old.Path, r.Old.Version); err != nil {
+			return fmt.Errorf("failed to drop replace %s from %s: %w", r.Old.Path, m.modPath, err)
+		}
+	}
+	m.file.Cleanup()
+	return writeGoMod(m)
+}
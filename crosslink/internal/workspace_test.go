@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crosslink
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"golang.org/x/mod/modfile"
+)
+
+func TestWorkspace(t *testing.T) {
+	lg, _ := zap.NewDevelopment()
+
+	tmpRootDir := createTempTestDir(t, "testSimple")
+	err := renameGoMod(tmpRootDir)
+	require.NoError(t, err)
+
+	cfg := RunConfig{
+		RootPath:           tmpRootDir,
+		Workspace:          true,
+		WorkspaceGoVersion: "1.20",
+		PruneReplaces:      true,
+		Logger:             lg,
+	}
+
+	require.NoError(t, Crosslink(cfg))
+
+	data, err := os.ReadFile(filepath.Clean(filepath.Join(tmpRootDir, "go.work")))
+	require.NoError(t, err)
+
+	wf, err := modfile.ParseWork("go.work", data, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "1.20", wf.Go.Version)
+
+	var uses []string
+	for _, u := range wf.Use {
+		uses = append(uses, u.Path)
+	}
+	sort.Strings(uses)
+	assert.Contains(t, uses, ".")
+	assert.Contains(t, uses, "./testA")
+	assert.Contains(t, uses, "./testB")
+}